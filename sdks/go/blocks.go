@@ -0,0 +1,48 @@
+package aurigraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetBlockByHeight retrieves the block at the given height.
+func (c *Client) GetBlockByHeight(ctx context.Context, height int64) (*Block, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/blocks/%d", height), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get block: status %d", resp.StatusCode)
+	}
+
+	var block Block
+	if err := json.NewDecoder(resp.Body).Decode(&block); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &block, nil
+}
+
+// GetBlockByHash retrieves the block with the given hash.
+func (c *Client) GetBlockByHash(ctx context.Context, hash string) (*Block, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/blocks/hash/%s", hash), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get block: status %d", resp.StatusCode)
+	}
+
+	var block Block
+	if err := json.NewDecoder(resp.Body).Decode(&block); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &block, nil
+}
@@ -0,0 +1,117 @@
+package aurigraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// MaxBulkSize is the largest batch Client.SubmitTransactions will accept in
+// a single call.
+const MaxBulkSize = 250
+
+// bulkConcurrency bounds how many transactions are submitted in parallel
+// when falling back to per-transaction submission.
+const bulkConcurrency = 10
+
+// SubmitTransactions submits a batch of transactions, preferring the
+// /transactions/bulk endpoint and falling back to bounded-concurrency
+// per-transaction submission if the bulk request fails for any reason
+// (including a server that doesn't support the endpoint). The
+// returned slices are index-aligned with txs; a nil error means that
+// transaction's result is valid.
+func (c *Client) SubmitTransactions(ctx context.Context, txs []map[string]interface{}) ([]*Transaction, []error) {
+	if len(txs) > MaxBulkSize {
+		err := fmt.Errorf("aurigraph: batch of %d transactions exceeds MaxBulkSize (%d)", len(txs), MaxBulkSize)
+		errs := make([]error, len(txs))
+		for i := range errs {
+			errs[i] = err
+		}
+		return nil, errs
+	}
+
+	results, err := c.submitBulk(ctx, txs)
+	if err == nil {
+		return results, make([]error, len(txs))
+	}
+
+	return c.submitConcurrently(ctx, txs)
+}
+
+// submitBulk tries the batch endpoint in one request.
+func (c *Client) submitBulk(ctx context.Context, txs []map[string]interface{}) ([]*Transaction, error) {
+	payload, err := json.Marshal(txs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", "/transactions/bulk", payload)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("aurigraph: bulk endpoint not supported")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bulk submission failed: status %d", resp.StatusCode)
+	}
+
+	var transactions []*Transaction
+	if err := json.NewDecoder(resp.Body).Decode(&transactions); err != nil {
+		return nil, fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// submitConcurrently submits each transaction individually, bounded by a
+// semaphore so at most bulkConcurrency requests are in flight at once.
+func (c *Client) submitConcurrently(ctx context.Context, txs []map[string]interface{}) ([]*Transaction, []error) {
+	results := make([]*Transaction, len(txs))
+	errs := make([]error, len(txs))
+
+	sem := make(chan struct{}, bulkConcurrency)
+	var wg sync.WaitGroup
+
+	for i, tx := range txs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, tx map[string]interface{}) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i], errs[i] = c.SubmitTransaction(ctx, tx)
+		}(i, tx)
+	}
+
+	wg.Wait()
+	return results, errs
+}
+
+// WaitForConfirmation polls GetTransaction at pollInterval until the
+// transaction's status is no longer "pending" or ctx is done.
+func (c *Client) WaitForConfirmation(ctx context.Context, hash string, pollInterval time.Duration) (*Transaction, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		tx, err := c.GetTransaction(ctx, hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for confirmation: %w", err)
+		}
+		if tx.Status != "pending" {
+			return tx, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for confirmation: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
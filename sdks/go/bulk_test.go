@@ -0,0 +1,45 @@
+package aurigraph
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSubmitTransactionsRejectsOversizedBatch(t *testing.T) {
+	txs := make([]map[string]interface{}, MaxBulkSize+1)
+	for i := range txs {
+		txs[i] = map[string]interface{}{"to": "0xdest", "amount": "1"}
+	}
+
+	c := &Client{}
+	results, errs := c.SubmitTransactions(nil, txs) //nolint:staticcheck // nil ctx never reaches the network on this path
+
+	if results != nil {
+		t.Errorf("expected nil results for an oversized batch, got %v", results)
+	}
+	if len(errs) != len(txs) {
+		t.Fatalf("expected %d errors, got %d", len(txs), len(errs))
+	}
+	for i, err := range errs {
+		if err == nil {
+			t.Fatalf("errs[%d] = nil, want a MaxBulkSize error", i)
+		}
+	}
+}
+
+// TestSubmitTransactionsAcceptsBatchAtLimit checks that a batch exactly at
+// MaxBulkSize isn't rejected by the size check — it may still fail further
+// down the stack (this Client has no live nodes), but not with the
+// exceeds-MaxBulkSize error every element gets when the batch is too big.
+func TestSubmitTransactionsAcceptsBatchAtLimit(t *testing.T) {
+	txs := make([]map[string]interface{}, MaxBulkSize)
+
+	c := &Client{}
+	_, errs := c.SubmitTransactions(nil, txs) //nolint:staticcheck // nil ctx never reaches the network on this path
+
+	for i, err := range errs {
+		if err != nil && strings.Contains(err.Error(), "exceeds MaxBulkSize") {
+			t.Fatalf("errs[%d] unexpectedly rejected at the MaxBulkSize boundary: %v", i, err)
+		}
+	}
+}
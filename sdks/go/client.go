@@ -11,20 +11,51 @@ import (
 	"time"
 )
 
+const (
+	defaultMaxRetries          = 3
+	defaultHealthCheckInterval = 30000 // ms
+	defaultMaxBlockLag         = 5
+)
+
 // Client represents the Aurigraph SDK client
 type Client struct {
-	config    *ClientConfig
+	config     *ClientConfig
 	httpClient *http.Client
-	connected bool
-	mu        sync.RWMutex
+	connected  bool
+	mu         sync.RWMutex
+
+	pool           []*Node
+	poolMu         sync.RWMutex
+	healthStop     chan struct{}
+	healthStopOnce sync.Once
+	healthWG       sync.WaitGroup
+
+	middlewares []Middleware
 }
 
-// NewClient creates a new Aurigraph client
+// attemptContextKey is the context.Context key under which doRequest records
+// the current retry attempt number.
+type attemptContextKey struct{}
+
+// AttemptFromContext returns the node-failover retry attempt (0 for the
+// first try) that produced the request carried by ctx, for use by
+// middleware that wants to log or tag it. It returns 0 if ctx didn't come
+// from a Client request.
+func AttemptFromContext(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptContextKey{}).(int)
+	return attempt
+}
+
+// NewClient creates a new Aurigraph client load-balanced across the given
+// endpoints and starts its background health-check loop.
 //
 // Example:
 //
 //	client := NewClient(&ClientConfig{
-//	    BaseURL: "https://dlt.aurigraph.io/api/v11",
+//	    Endpoints: []string{
+//	        "https://dlt1.aurigraph.io/api/v11",
+//	        "https://dlt2.aurigraph.io/api/v11",
+//	    },
 //	    APIKey:  "sk_...",
 //	    Timeout: 30000,
 //	})
@@ -32,14 +63,37 @@ func NewClient(config *ClientConfig) *Client {
 	if config.Timeout == 0 {
 		config.Timeout = 30000
 	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = defaultMaxRetries
+	}
+	if config.HealthCheckInterval == 0 {
+		config.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if config.MaxBlockLag == 0 {
+		config.MaxBlockLag = defaultMaxBlockLag
+	}
+
+	pool := make([]*Node, 0, len(config.Endpoints))
+	for _, url := range config.Endpoints {
+		pool = append(pool, &Node{URL: url})
+	}
 
-	return &Client{
+	c := &Client{
 		config: config,
 		httpClient: &http.Client{
 			Timeout: time.Duration(config.Timeout) * time.Millisecond,
 		},
-		connected: false,
+		connected:  false,
+		pool:       pool,
+		healthStop: make(chan struct{}),
 	}
+
+	c.runHealthChecks()
+
+	c.healthWG.Add(1)
+	go c.initHealthCheck()
+
+	return c
 }
 
 // Connect establishes connection to the Aurigraph network
@@ -47,22 +101,11 @@ func (c *Client) Connect(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	req, err := http.NewRequestWithContext(ctx, "GET", c.config.BaseURL+"/health", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.addHeaders(req)
+	c.runHealthChecks()
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
+	if _, err := c.nextNode(nil); err != nil {
 		return fmt.Errorf("failed to connect to Aurigraph: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("health check failed with status %d", resp.StatusCode)
-	}
 
 	c.connected = true
 	fmt.Println("✅ Connected to Aurigraph network")
@@ -86,21 +129,96 @@ func (c *Client) IsConnected() bool {
 	return c.connected
 }
 
-// GetAccount retrieves account information
-func (c *Client) GetAccount(ctx context.Context, address string) (*Account, error) {
+// PoolStatus returns a point-in-time snapshot of every pooled node.
+func (c *Client) PoolStatus() []NodeStatus {
+	c.poolMu.RLock()
+	defer c.poolMu.RUnlock()
+
+	statuses := make([]NodeStatus, len(c.pool))
+	for i, n := range c.pool {
+		statuses[i] = NodeStatus{
+			URL:            n.URL,
+			Alive:          n.IsAlive(),
+			LastSeenHeight: n.LastSeenHeight(),
+			InFlight:       n.InFlight(),
+		}
+	}
+	return statuses
+}
+
+// Close stops the background health-check goroutine. The client must not be
+// used after Close returns.
+func (c *Client) Close() error {
+	c.healthStopOnce.Do(func() {
+		close(c.healthStop)
+	})
+	c.healthWG.Wait()
+	return nil
+}
+
+// doRequest performs an HTTP request against the pool, picking the
+// least-loaded alive node and transparently retrying against a different
+// node on network errors or 5xx responses, up to config.MaxRetries times.
+func (c *Client) doRequest(ctx context.Context, method, path string, payload []byte) (*http.Response, error) {
 	if !c.IsConnected() {
 		return nil, fmt.Errorf("client not connected")
 	}
 
-	url := fmt.Sprintf("%s/accounts/%s", c.config.BaseURL, address)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	excluded := map[string]bool{}
+	var lastErr error
+
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		node, err := c.nextNode(excluded)
+		if err != nil {
+			if lastErr != nil {
+				return nil, fmt.Errorf("%w (last attempt error: %v)", err, lastErr)
+			}
+			return nil, err
+		}
+
+		attemptCtx := context.WithValue(ctx, attemptContextKey{}, attempt)
+
+		var body io.Reader
+		if payload != nil {
+			body = bytes.NewReader(payload)
+		}
+
+		req, err := http.NewRequestWithContext(attemptCtx, method, node.URL+path, body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		c.addHeaders(req)
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		node.incInFlight()
+		resp, err := c.roundTrip(req)
+		node.decInFlight()
+
+		if err != nil {
+			lastErr = err
+			excluded[node.URL] = true
+			continue
+		}
+
+		if resp.StatusCode >= 500 {
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			lastErr = fmt.Errorf("status %d, body: %s", resp.StatusCode, string(respBody))
+			excluded[node.URL] = true
+			continue
+		}
+
+		return resp, nil
 	}
 
-	c.addHeaders(req)
+	return nil, fmt.Errorf("request failed after %d attempts: %w", c.config.MaxRetries+1, lastErr)
+}
 
-	resp, err := c.httpClient.Do(req)
+// GetAccount retrieves account information
+func (c *Client) GetAccount(ctx context.Context, address string) (*Account, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/accounts/%s", address), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get account: %w", err)
 	}
@@ -129,25 +247,12 @@ func (c *Client) GetBalance(ctx context.Context, address string) (string, error)
 
 // SubmitTransaction submits a transaction to the network
 func (c *Client) SubmitTransaction(ctx context.Context, tx map[string]interface{}) (*Transaction, error) {
-	if !c.IsConnected() {
-		return nil, fmt.Errorf("client not connected")
-	}
-
 	txJSON, err := json.Marshal(tx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal transaction: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/transactions", c.config.BaseURL)
-	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(txJSON))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.addHeaders(req)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(ctx, "POST", "/transactions", txJSON)
 	if err != nil {
 		return nil, fmt.Errorf("failed to submit transaction: %w", err)
 	}
@@ -168,19 +273,7 @@ func (c *Client) SubmitTransaction(ctx context.Context, tx map[string]interface{
 
 // GetTransaction retrieves transaction details
 func (c *Client) GetTransaction(ctx context.Context, hash string) (*Transaction, error) {
-	if !c.IsConnected() {
-		return nil, fmt.Errorf("client not connected")
-	}
-
-	url := fmt.Sprintf("%s/transactions/%s", c.config.BaseURL, hash)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.addHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/transactions/%s", hash), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transaction: %w", err)
 	}
@@ -200,19 +293,7 @@ func (c *Client) GetTransaction(ctx context.Context, hash string) (*Transaction,
 
 // GetLatestBlock retrieves the latest block on the blockchain
 func (c *Client) GetLatestBlock(ctx context.Context) (*Block, error) {
-	if !c.IsConnected() {
-		return nil, fmt.Errorf("client not connected")
-	}
-
-	url := fmt.Sprintf("%s/blocks/latest", c.config.BaseURL)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.addHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(ctx, "GET", "/blocks/latest", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get latest block: %w", err)
 	}
@@ -0,0 +1,36 @@
+package aurigraph
+
+import "net/http"
+
+// RoundTripFunc performs a single HTTP round trip. It has the same shape as
+// http.Client.Do, which lets a *http.Client sit at the base of a Middleware
+// chain.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to add cross-cutting behavior (logging,
+// metrics, tracing, retries, ...) around every request the Client makes.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use registers a middleware. Middlewares run in the order they were
+// registered: the first one added is outermost, closest to the caller, and
+// the last one added runs closest to the network.
+func (c *Client) Use(mw Middleware) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.middlewares = append(c.middlewares, mw)
+}
+
+// roundTrip executes req through the registered middleware chain, with the
+// underlying http.Client at its base.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.RLock()
+	middlewares := make([]Middleware, len(c.middlewares))
+	copy(middlewares, c.middlewares)
+	c.mu.RUnlock()
+
+	rt := RoundTripFunc(c.httpClient.Do)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt(req)
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+)
+
+// Debug writes the raw request and response bodies to w, for use while
+// diagnosing an integration. It is not intended to stay enabled in
+// production since it copies every body through memory.
+func Debug(w io.Writer) aurigraph.Middleware {
+	return func(next aurigraph.RoundTripFunc) aurigraph.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Body != nil {
+				reqBody, _ := io.ReadAll(req.Body)
+				req.Body.Close()
+				req.Body = io.NopCloser(bytes.NewReader(reqBody))
+				fmt.Fprintf(w, "--> %s %s\n%s\n", req.Method, req.URL, reqBody)
+			} else {
+				fmt.Fprintf(w, "--> %s %s\n", req.Method, req.URL)
+			}
+
+			resp, err := next(req)
+			if err != nil {
+				fmt.Fprintf(w, "<-- error: %v\n", err)
+				return resp, err
+			}
+
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+			fmt.Fprintf(w, "<-- %d %s\n%s\n", resp.StatusCode, req.URL, respBody)
+
+			return resp, nil
+		}
+	}
+}
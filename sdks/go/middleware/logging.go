@@ -0,0 +1,38 @@
+// Package middleware provides built-in aurigraph.Middleware implementations
+// for observability and resilience: structured logging, Prometheus metrics,
+// OpenTelemetry tracing, retry-with-backoff, and debug body capture.
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+)
+
+// Logging logs every request and response (method, URL, status, duration)
+// through logger at info level, and at warn level if the round trip itself
+// errored.
+func Logging(logger *slog.Logger) aurigraph.Middleware {
+	return func(next aurigraph.RoundTripFunc) aurigraph.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			duration := time.Since(start)
+			attempt := aurigraph.AttemptFromContext(req.Context())
+
+			if err != nil {
+				logger.Warn("aurigraph request failed",
+					"method", req.Method, "url", req.URL.String(),
+					"attempt", attempt, "duration", duration, "error", err)
+				return resp, err
+			}
+
+			logger.Info("aurigraph request",
+				"method", req.Method, "url", req.URL.String(),
+				"attempt", attempt, "status", resp.StatusCode, "duration", duration)
+			return resp, err
+		}
+	}
+}
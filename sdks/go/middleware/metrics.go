@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+)
+
+// Metrics records per-request counts, labeled by method, path and status
+// code, and latency, labeled by method and path, into a prometheus.Registerer.
+func Metrics(reg prometheus.Registerer) aurigraph.Middleware {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aurigraph_client_requests_total",
+		Help: "Total Aurigraph SDK requests, labeled by method, path and status.",
+	}, []string{"method", "path", "status"})
+
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "aurigraph_client_request_duration_seconds",
+		Help:    "Aurigraph SDK request latency in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "path"})
+
+	reg.MustRegister(requests, latency)
+
+	return func(next aurigraph.RoundTripFunc) aurigraph.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			path := routeTemplate(req.URL.Path)
+			latency.WithLabelValues(req.Method, path).Observe(time.Since(start).Seconds())
+
+			status := "error"
+			if err == nil {
+				status = strconv.Itoa(resp.StatusCode)
+			}
+			requests.WithLabelValues(req.Method, path, status).Inc()
+
+			return resp, err
+		}
+	}
+}
+
+// routeTemplate collapses a concrete Aurigraph SDK path (e.g.
+// "/accounts/0xabc...") down to its route template (e.g. "/accounts/:addr"),
+// so the id/hash/address segment doesn't blow up the cardinality of the
+// path label on long-running processes. Routes are matched on the path's
+// trailing segments, so an endpoint base path such as "/api/v11" is kept in
+// front of the template.
+func routeTemplate(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	n := len(segments)
+
+	switch {
+	case n >= 2 && segments[n-2] == "accounts":
+		segments[n-1] = ":addr"
+	case n >= 2 && segments[n-2] == "transactions" && segments[n-1] != "bulk":
+		segments[n-1] = ":hash"
+	case n >= 3 && segments[n-3] == "blocks" && segments[n-2] == "hash":
+		segments[n-1] = ":hash"
+	case n >= 2 && segments[n-2] == "blocks" && segments[n-1] != "latest":
+		segments[n-1] = ":height"
+	default:
+		return path
+	}
+	return "/" + strings.Join(segments, "/")
+}
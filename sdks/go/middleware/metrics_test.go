@@ -0,0 +1,19 @@
+package middleware
+
+import "testing"
+
+func TestRouteTemplate(t *testing.T) {
+	cases := map[string]string{
+		"/accounts/0xabc123":       "/accounts/:addr",
+		"/transactions/0xdeadbeef": "/transactions/:hash",
+		"/transactions/bulk":       "/transactions/bulk",
+		"/blocks/latest":           "/blocks/latest",
+		"/health":                  "/health",
+	}
+
+	for path, want := range cases {
+		if got := routeTemplate(path); got != want {
+			t.Errorf("routeTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
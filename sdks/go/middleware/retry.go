@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+)
+
+// Retry resends a request up to maxAttempts times (including the first) when
+// it comes back 429 or 5xx, honoring a Retry-After header when present and
+// otherwise backing off exponentially starting at initialBackoff.
+//
+// This operates below Client's node-failover retries in doRequest: it
+// handles a single node asking the caller to slow down or retry, rather
+// than routing around a dead node.
+func Retry(maxAttempts int, initialBackoff time.Duration) aurigraph.Middleware {
+	return func(next aurigraph.RoundTripFunc) aurigraph.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			var body []byte
+			if req.Body != nil {
+				body, _ = io.ReadAll(req.Body)
+				req.Body.Close()
+			}
+
+			backoff := initialBackoff
+			var resp *http.Response
+			var err error
+
+			for attempt := 0; attempt < maxAttempts; attempt++ {
+				if body != nil {
+					req.Body = io.NopCloser(bytes.NewReader(body))
+				}
+
+				resp, err = next(req)
+				if err != nil {
+					return resp, err
+				}
+				if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+					return resp, nil
+				}
+				if attempt == maxAttempts-1 {
+					return resp, nil
+				}
+
+				wait := retryAfter(resp.Header.Get("Retry-After"), backoff)
+				resp.Body.Close()
+
+				select {
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
+				case <-time.After(wait):
+				}
+				backoff *= 2
+			}
+
+			return resp, err
+		}
+	}
+}
+
+// retryAfter parses an RFC-7231 Retry-After header (seconds form), falling
+// back to def when the header is absent or unparseable.
+func retryAfter(header string, def time.Duration) time.Duration {
+	if header == "" {
+		return def
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	return def
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newPOSTRequest(t *testing.T, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, "https://node.example.com/transactions", bytes.NewReader([]byte(body)))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	return req
+}
+
+func TestRetryHonorsRetryAfterHeader(t *testing.T) {
+	var attempts int
+	var elapsed []time.Duration
+	last := time.Now()
+
+	next := func(req *http.Request) (*http.Response, error) {
+		attempts++
+		elapsed = append(elapsed, time.Since(last))
+		last = time.Now()
+		if attempts == 1 {
+			resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}
+			resp.Header.Set("Retry-After", "0")
+			return resp, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+	}
+
+	resp, err := Retry(3, time.Minute)(next)(newPOSTRequest(t, "payload"))
+	if err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want 200", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+	// The 429's Retry-After: 0 should have been honored instead of the
+	// minute-long default backoff.
+	if elapsed[1] > 5*time.Second {
+		t.Errorf("retry waited %s, want it to honor the zero-second Retry-After header instead of the default backoff", elapsed[1])
+	}
+}
+
+func TestRetryExhaustsAttemptsOn5xx(t *testing.T) {
+	var attempts int
+	next := func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+	}
+
+	resp, err := Retry(3, time.Millisecond)(next)(newPOSTRequest(t, "payload"))
+	if err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("StatusCode = %d, want 503 (the last attempt's response, returned once attempts are exhausted)", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("attempts = %d, want exactly maxAttempts (3)", attempts)
+	}
+}
+
+func TestRetryDoesNotRetryOnSuccess(t *testing.T) {
+	var attempts int
+	next := func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+	}
+
+	if _, err := Retry(3, time.Millisecond)(next)(newPOSTRequest(t, "payload")); err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1 (no retry on a 2xx response)", attempts)
+	}
+}
+
+func TestRetryReplaysRequestBodyAcrossAttempts(t *testing.T) {
+	const payload = `{"to":"0xdest","amount":"1"}`
+	var seenBodies []string
+
+	next := func(req *http.Request) (*http.Response, error) {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		seenBodies = append(seenBodies, string(b))
+
+		if len(seenBodies) < 3 {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+	}
+
+	if _, err := Retry(3, time.Millisecond)(next)(newPOSTRequest(t, payload)); err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+
+	if len(seenBodies) != 3 {
+		t.Fatalf("next was called %d times, want 3", len(seenBodies))
+	}
+	for i, b := range seenBodies {
+		if b != payload {
+			t.Errorf("attempt %d saw body %q, want the full original payload replayed", i, b)
+		}
+	}
+}
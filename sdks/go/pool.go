@@ -0,0 +1,193 @@
+package aurigraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Node represents a single Aurigraph endpoint in the client's connection
+// pool, tracked for liveness, block height and in-flight request count.
+type Node struct {
+	URL string
+
+	mu             sync.RWMutex
+	alive          bool
+	lastSeenHeight int64
+	inFlight       int64
+}
+
+// IsAlive reports whether the node last passed its health check.
+func (n *Node) IsAlive() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.alive
+}
+
+// LastSeenHeight returns the most recent block height observed on this node.
+func (n *Node) LastSeenHeight() int64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.lastSeenHeight
+}
+
+// InFlight returns the number of requests currently in flight against this node.
+func (n *Node) InFlight() int64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.inFlight
+}
+
+func (n *Node) setAlive(alive bool) {
+	n.mu.Lock()
+	n.alive = alive
+	n.mu.Unlock()
+}
+
+func (n *Node) setLastSeenHeight(height int64) {
+	n.mu.Lock()
+	n.lastSeenHeight = height
+	n.mu.Unlock()
+}
+
+func (n *Node) incInFlight() {
+	n.mu.Lock()
+	n.inFlight++
+	n.mu.Unlock()
+}
+
+func (n *Node) decInFlight() {
+	n.mu.Lock()
+	n.inFlight--
+	n.mu.Unlock()
+}
+
+// nextNode picks the alive node with the fewest in-flight requests,
+// excluding any URLs already tried for the current request (least-connections;
+// ties go to the node that comes first in the pool).
+func (c *Client) nextNode(exclude map[string]bool) (*Node, error) {
+	c.poolMu.RLock()
+	defer c.poolMu.RUnlock()
+
+	var best *Node
+	var bestLoad int64
+	for _, n := range c.pool {
+		if exclude[n.URL] || !n.IsAlive() {
+			continue
+		}
+		load := n.InFlight()
+		if best == nil || load < bestLoad {
+			best, bestLoad = n, load
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("aurigraph: no alive nodes available")
+	}
+	return best, nil
+}
+
+// initHealthCheck runs the periodic health-check loop until Close stops it.
+func (c *Client) initHealthCheck() {
+	defer c.healthWG.Done()
+
+	ticker := time.NewTicker(time.Duration(c.config.HealthCheckInterval) * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.healthStop:
+			return
+		case <-ticker.C:
+			c.runHealthChecks()
+		}
+	}
+}
+
+// runHealthChecks polls every pooled node and marks nodes down that fail
+// outright or that lag the highest observed block height by more than
+// MaxBlockLag.
+func (c *Client) runHealthChecks() {
+	c.poolMu.RLock()
+	nodes := make([]*Node, len(c.pool))
+	copy(nodes, c.pool)
+	c.poolMu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+			c.checkNode(n)
+		}(n)
+	}
+	wg.Wait()
+
+	var maxHeight int64
+	for _, n := range nodes {
+		if n.IsAlive() {
+			if h := n.LastSeenHeight(); h > maxHeight {
+				maxHeight = h
+			}
+		}
+	}
+
+	for _, n := range nodes {
+		if n.IsAlive() && maxHeight-n.LastSeenHeight() > c.config.MaxBlockLag {
+			n.setAlive(false)
+		}
+	}
+}
+
+// checkNode probes a single node's /health and /blocks/latest endpoints.
+func (c *Client) checkNode(n *Node) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.httpClient.Timeout)
+	defer cancel()
+
+	healthReq, err := http.NewRequestWithContext(ctx, "GET", n.URL+"/health", nil)
+	if err != nil {
+		n.setAlive(false)
+		return
+	}
+	c.addHeaders(healthReq)
+
+	healthResp, err := c.httpClient.Do(healthReq)
+	if err != nil {
+		n.setAlive(false)
+		return
+	}
+	healthResp.Body.Close()
+	if healthResp.StatusCode != http.StatusOK {
+		n.setAlive(false)
+		return
+	}
+
+	blockReq, err := http.NewRequestWithContext(ctx, "GET", n.URL+"/blocks/latest", nil)
+	if err != nil {
+		n.setAlive(false)
+		return
+	}
+	c.addHeaders(blockReq)
+
+	blockResp, err := c.httpClient.Do(blockReq)
+	if err != nil {
+		n.setAlive(false)
+		return
+	}
+	defer blockResp.Body.Close()
+	if blockResp.StatusCode != http.StatusOK {
+		n.setAlive(false)
+		return
+	}
+
+	var block Block
+	if err := json.NewDecoder(blockResp.Body).Decode(&block); err != nil {
+		n.setAlive(false)
+		return
+	}
+
+	n.setLastSeenHeight(block.Height)
+	n.setAlive(true)
+}
@@ -0,0 +1,54 @@
+package aurigraph
+
+import "testing"
+
+func TestNextNodeSkipsDeadAndPicksLeastConnections(t *testing.T) {
+	dead := &Node{URL: "https://dead"}
+	dead.setAlive(false)
+
+	busy := &Node{URL: "https://busy"}
+	busy.setAlive(true)
+	busy.incInFlight()
+	busy.incInFlight()
+
+	idle := &Node{URL: "https://idle"}
+	idle.setAlive(true)
+
+	c := &Client{pool: []*Node{dead, busy, idle}}
+
+	got, err := c.nextNode(nil)
+	if err != nil {
+		t.Fatalf("nextNode: %v", err)
+	}
+	if got != idle {
+		t.Errorf("nextNode() = %s, want %s (fewest in-flight among alive nodes)", got.URL, idle.URL)
+	}
+}
+
+func TestNextNodeHonorsExcludeSet(t *testing.T) {
+	a := &Node{URL: "https://a"}
+	a.setAlive(true)
+	b := &Node{URL: "https://b"}
+	b.setAlive(true)
+
+	c := &Client{pool: []*Node{a, b}}
+
+	got, err := c.nextNode(map[string]bool{"https://a": true})
+	if err != nil {
+		t.Fatalf("nextNode: %v", err)
+	}
+	if got != b {
+		t.Errorf("nextNode() = %s, want %s (excluded node must not be picked)", got.URL, b.URL)
+	}
+}
+
+func TestNextNodeErrorsWhenNoneAlive(t *testing.T) {
+	dead := &Node{URL: "https://dead"}
+	dead.setAlive(false)
+
+	c := &Client{pool: []*Node{dead}}
+
+	if _, err := c.nextNode(nil); err == nil {
+		t.Fatal("expected an error when no nodes are alive")
+	}
+}
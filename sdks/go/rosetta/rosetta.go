@@ -0,0 +1,351 @@
+// Package rosetta adapts the Aurigraph Go SDK to the Coinbase Rosetta Data
+// and Construction API, so exchanges and wallets that already speak Rosetta
+// can integrate with Aurigraph without writing any chain-specific code.
+package rosetta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// BlockchainName and NetworkName identify Aurigraph to Rosetta callers.
+const (
+	BlockchainName = "aurigraph"
+	NetworkName    = "mainnet"
+)
+
+// Client wraps an aurigraph.Client and exposes it through Rosetta's Data and
+// Construction API shapes.
+type Client struct {
+	aurigraph *aurigraph.Client
+}
+
+// NewClient builds a Rosetta-compatible wrapper around an existing,
+// already-connected Aurigraph client.
+func NewClient(client *aurigraph.Client) *Client {
+	return &Client{aurigraph: client}
+}
+
+// NetworkIdentifier returns the Rosetta network identifier for Aurigraph.
+func (c *Client) NetworkIdentifier() *types.NetworkIdentifier {
+	return &types.NetworkIdentifier{
+		Blockchain: BlockchainName,
+		Network:    NetworkName,
+	}
+}
+
+// NetworkStatus implements the /network/status endpoint.
+func (c *Client) NetworkStatus(ctx context.Context) (*types.NetworkStatusResponse, error) {
+	block, err := c.aurigraph.GetLatestBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: failed to fetch network status: %w", err)
+	}
+
+	return &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: blockIdentifier(block),
+		CurrentBlockTimestamp:  block.Timestamp,
+	}, nil
+}
+
+// AccountBalance implements the /account/balance endpoint.
+func (c *Client) AccountBalance(ctx context.Context, address string) (*types.AccountBalanceResponse, error) {
+	account, err := c.aurigraph.GetAccount(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: failed to fetch account balance: %w", err)
+	}
+
+	block, err := c.aurigraph.GetLatestBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: failed to fetch account balance: %w", err)
+	}
+
+	return &types.AccountBalanceResponse{
+		BlockIdentifier: blockIdentifier(block),
+		Balances: []*types.Amount{
+			nativeAmount(account.Balance),
+		},
+	}, nil
+}
+
+// Block implements the /block endpoint, resolving blockID by hash or index
+// (falling back to the latest block when neither is set, per the Rosetta
+// spec) rather than always returning the current tip.
+func (c *Client) Block(ctx context.Context, blockID *types.PartialBlockIdentifier) (*types.BlockResponse, error) {
+	block, err := c.resolveBlock(ctx, blockID)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: failed to fetch block: %w", err)
+	}
+
+	return &types.BlockResponse{
+		Block: &types.Block{
+			BlockIdentifier:       blockIdentifier(block),
+			ParentBlockIdentifier: &types.BlockIdentifier{Hash: block.PrevHash, Index: block.Height - 1},
+			Timestamp:             block.Timestamp,
+			// Aurigraph's Block type doesn't yet carry its constituent
+			// transaction hashes, so Transactions is left empty here;
+			// populating it needs a richer /blocks endpoint server-side.
+		},
+	}, nil
+}
+
+// resolveBlock looks up the block blockID identifies, preferring hash over
+// index when both are present, and falling back to the latest block when
+// blockID is nil or carries neither.
+func (c *Client) resolveBlock(ctx context.Context, blockID *types.PartialBlockIdentifier) (*aurigraph.Block, error) {
+	switch {
+	case blockID != nil && blockID.Hash != nil && *blockID.Hash != "":
+		return c.aurigraph.GetBlockByHash(ctx, *blockID.Hash)
+	case blockID != nil && blockID.Index != nil:
+		return c.aurigraph.GetBlockByHeight(ctx, *blockID.Index)
+	default:
+		return c.aurigraph.GetLatestBlock(ctx)
+	}
+}
+
+// transactionToRosetta translates an Aurigraph transfer into the
+// debit/credit operation pair Rosetta expects.
+func transactionToRosetta(tx *aurigraph.Transaction) *types.Transaction {
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: tx.Hash},
+		Operations: []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 0},
+				Type:                "TRANSFER",
+				Status:              types.String(rosettaStatus(tx.Status)),
+				Account:             &types.AccountIdentifier{Address: tx.From},
+				Amount:              negativeAmount(tx.Amount),
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 1},
+				RelatedOperations:   []*types.OperationIdentifier{{Index: 0}},
+				Type:                "TRANSFER",
+				Status:              types.String(rosettaStatus(tx.Status)),
+				Account:             &types.AccountIdentifier{Address: tx.To},
+				Amount:              nativeAmount(tx.Amount),
+			},
+		},
+	}
+}
+
+// rosettaStatus maps Aurigraph's status strings onto Rosetta's Success/Failure
+// convention; pending transactions are reported unchanged so callers know to
+// poll rather than treat them as final.
+func rosettaStatus(status string) string {
+	switch status {
+	case "confirmed":
+		return "SUCCESS"
+	case "failed":
+		return "FAILURE"
+	default:
+		return status
+	}
+}
+
+func blockIdentifier(block *aurigraph.Block) *types.BlockIdentifier {
+	return &types.BlockIdentifier{Hash: block.Hash, Index: block.Height}
+}
+
+func nativeAmount(value string) *types.Amount {
+	return &types.Amount{Value: value, Currency: &types.Currency{Symbol: "AURI", Decimals: 18}}
+}
+
+func negativeAmount(value string) *types.Amount {
+	return &types.Amount{Value: "-" + value, Currency: &types.Currency{Symbol: "AURI", Decimals: 18}}
+}
+
+// transferPayload is the opaque "from/to/amount/nonce" intent that
+// /construction/preprocess and /construction/payloads pass between each
+// other via Options/Metadata, ahead of signing.
+type transferPayload struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	Nonce  int64  `json:"nonce"`
+}
+
+// BlockTransaction implements /block/transaction, returning the Rosetta
+// operations for a single transaction.
+func (c *Client) BlockTransaction(ctx context.Context, hash string) (*types.BlockTransactionResponse, error) {
+	tx, err := c.aurigraph.GetTransaction(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: failed to fetch block transaction: %w", err)
+	}
+
+	return &types.BlockTransactionResponse{Transaction: transactionToRosetta(tx)}, nil
+}
+
+// ConstructionPreprocess implements /construction/preprocess: it extracts the
+// transfer intent from the submitted operations so the caller can fetch
+// whatever metadata (here, the sender's nonce) is needed to build the
+// unsigned transaction.
+func (c *Client) ConstructionPreprocess(ops []*types.Operation) (*types.ConstructionPreprocessResponse, error) {
+	transfer, err := transferFromOperations(ops)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/preprocess: %w", err)
+	}
+
+	options, err := toOptionsMap(transfer)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/preprocess: %w", err)
+	}
+
+	return &types.ConstructionPreprocessResponse{Options: options}, nil
+}
+
+// ConstructionMetadata implements /construction/metadata: it resolves the
+// sender's current nonce so construction can proceed fully offline from here.
+func (c *Client) ConstructionMetadata(ctx context.Context, options map[string]interface{}) (*types.ConstructionMetadataResponse, error) {
+	transfer, err := transferFromOptions(options)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/metadata: %w", err)
+	}
+
+	account, err := c.aurigraph.GetAccount(ctx, transfer.From)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/metadata: %w", err)
+	}
+	transfer.Nonce = account.Nonce
+
+	metadata, err := toOptionsMap(transfer)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/metadata: %w", err)
+	}
+
+	return &types.ConstructionMetadataResponse{Metadata: metadata}, nil
+}
+
+// ConstructionPayloads implements /construction/payloads: it builds the
+// unsigned transaction bytes and the signing payload derived from them.
+func (c *Client) ConstructionPayloads(metadata map[string]interface{}) (*types.ConstructionPayloadsResponse, error) {
+	transfer, err := transferFromOptions(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/payloads: %w", err)
+	}
+
+	unsignedJSON, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/payloads: %w", err)
+	}
+
+	return &types.ConstructionPayloadsResponse{
+		UnsignedTransaction: string(unsignedJSON),
+		Payloads: []*types.SigningPayload{
+			{
+				AccountIdentifier: &types.AccountIdentifier{Address: transfer.From},
+				Bytes:             unsignedJSON,
+				SignatureType:     types.Ecdsa,
+			},
+		},
+	}, nil
+}
+
+// ConstructionCombine implements /construction/combine: it attaches the
+// caller-supplied signature to the unsigned transaction to produce the
+// signed transaction that /construction/submit will broadcast.
+func (c *Client) ConstructionCombine(unsignedTransaction string, signatures []*types.Signature) (*types.ConstructionCombineResponse, error) {
+	if len(signatures) != 1 {
+		return nil, fmt.Errorf("rosetta: construction/combine: expected exactly one signature, got %d", len(signatures))
+	}
+
+	signed := struct {
+		Transaction json.RawMessage `json:"transaction"`
+		Signature   string          `json:"signature"`
+	}{
+		Transaction: json.RawMessage(unsignedTransaction),
+		Signature:   fmt.Sprintf("%x", signatures[0].Bytes),
+	}
+
+	signedJSON, err := json.Marshal(signed)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/combine: %w", err)
+	}
+
+	return &types.ConstructionCombineResponse{SignedTransaction: string(signedJSON)}, nil
+}
+
+// ConstructionSubmit implements /construction/submit: it broadcasts the
+// signed transaction via the underlying Aurigraph client.
+func (c *Client) ConstructionSubmit(ctx context.Context, signedTransaction string) (*types.TransactionIdentifierResponse, error) {
+	var tx map[string]interface{}
+	if err := json.Unmarshal([]byte(signedTransaction), &tx); err != nil {
+		return nil, fmt.Errorf("rosetta: construction/submit: invalid signed transaction: %w", err)
+	}
+
+	submitted, err := c.aurigraph.SubmitTransaction(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: construction/submit: %w", err)
+	}
+
+	return &types.TransactionIdentifierResponse{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: submitted.Hash},
+	}, nil
+}
+
+// transferFromOperations extracts a transfer intent from a debit/credit
+// operation pair. Callers aren't guaranteed to submit debit before credit,
+// so the two are told apart by amount sign (negative = debit), not position.
+func transferFromOperations(ops []*types.Operation) (*transferPayload, error) {
+	if len(ops) != 2 {
+		return nil, fmt.Errorf("expected exactly 2 operations (debit, credit), got %d", len(ops))
+	}
+
+	var debit, credit *types.Operation
+	for _, op := range ops {
+		if op.Account == nil || op.Amount == nil || op.Amount.Value == "" {
+			return nil, fmt.Errorf("malformed transfer operation")
+		}
+		if strings.HasPrefix(op.Amount.Value, "-") {
+			debit = op
+		} else {
+			credit = op
+		}
+	}
+	if debit == nil || credit == nil {
+		return nil, fmt.Errorf("transfer operations must contain exactly one debit (negative amount) and one credit (positive amount)")
+	}
+
+	return &transferPayload{
+		From:   debit.Account.Address,
+		To:     credit.Account.Address,
+		Amount: trimSign(debit.Amount.Value),
+	}, nil
+}
+
+func trimSign(value string) string {
+	if len(value) > 0 && value[0] == '-' {
+		return value[1:]
+	}
+	return value
+}
+
+func toOptionsMap(transfer *transferPayload) (map[string]interface{}, error) {
+	raw, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, err
+	}
+	var options map[string]interface{}
+	if err := json.Unmarshal(raw, &options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+func transferFromOptions(options map[string]interface{}) (*transferPayload, error) {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	var transfer transferPayload
+	if err := json.Unmarshal(raw, &transfer); err != nil {
+		return nil, err
+	}
+	if transfer.From == "" || transfer.To == "" || transfer.Amount == "" {
+		return nil, fmt.Errorf("missing from/to/amount")
+	}
+	return &transfer, nil
+}
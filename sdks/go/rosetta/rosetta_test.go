@@ -0,0 +1,264 @@
+package rosetta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+)
+
+// newTestClient spins up an in-memory Aurigraph node serving fixed account,
+// transaction and block fixtures, and returns a connected aurigraph.Client
+// pointed at it.
+func newTestClient(t *testing.T) *aurigraph.Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(aurigraph.HealthResponse{Status: "ok"})
+	})
+	mux.HandleFunc("/blocks/latest", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(aurigraph.Block{Hash: "0xtip", Height: 100, Timestamp: 1000, PrevHash: "0xprev99"})
+	})
+	mux.HandleFunc("/blocks/42", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(aurigraph.Block{Hash: "0xblock42", Height: 42, Timestamp: 420, PrevHash: "0xblock41"})
+	})
+	mux.HandleFunc("/blocks/hash/0xblock42", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(aurigraph.Block{Hash: "0xblock42", Height: 42, Timestamp: 420, PrevHash: "0xblock41"})
+	})
+	mux.HandleFunc("/accounts/0xsender", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(aurigraph.Account{Address: "0xsender", Balance: "500", Nonce: 7})
+	})
+	mux.HandleFunc("/transactions/0xtxhash", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(aurigraph.Transaction{
+			Hash: "0xtxhash", From: "0xsender", To: "0xrecipient",
+			Amount: "100", Nonce: 7, Timestamp: 1000, Status: "confirmed",
+		})
+	})
+	mux.HandleFunc("/transactions", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(aurigraph.Transaction{
+			Hash: "0xsubmitted", From: "0xsender", To: "0xrecipient",
+			Amount: "100", Nonce: 7, Status: "pending",
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := aurigraph.NewClient(&aurigraph.ClientConfig{Endpoints: []string{server.URL}})
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+
+	return client
+}
+
+func TestRosettaStatus(t *testing.T) {
+	cases := map[string]string{
+		"confirmed": "SUCCESS",
+		"failed":    "FAILURE",
+		"pending":   "pending",
+	}
+	for status, want := range cases {
+		if got := rosettaStatus(status); got != want {
+			t.Errorf("rosettaStatus(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestTransactionToRosetta(t *testing.T) {
+	tx := &aurigraph.Transaction{Hash: "0xtxhash", From: "0xsender", To: "0xrecipient", Amount: "100", Status: "confirmed"}
+	rtx := transactionToRosetta(tx)
+
+	if rtx.TransactionIdentifier.Hash != "0xtxhash" {
+		t.Errorf("TransactionIdentifier.Hash = %q, want 0xtxhash", rtx.TransactionIdentifier.Hash)
+	}
+	if len(rtx.Operations) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(rtx.Operations))
+	}
+
+	debit, credit := rtx.Operations[0], rtx.Operations[1]
+	if debit.Account.Address != "0xsender" || debit.Amount.Value != "-100" {
+		t.Errorf("debit operation = %+v, want account 0xsender amount -100", debit)
+	}
+	if credit.Account.Address != "0xrecipient" || credit.Amount.Value != "100" {
+		t.Errorf("credit operation = %+v, want account 0xrecipient amount 100", credit)
+	}
+	if *debit.Status != "SUCCESS" || *credit.Status != "SUCCESS" {
+		t.Errorf("expected both operations to report SUCCESS status")
+	}
+}
+
+func operation(address, amount string) *types.Operation {
+	return &types.Operation{
+		Account: &types.AccountIdentifier{Address: address},
+		Amount:  &types.Amount{Value: amount},
+	}
+}
+
+func TestTransferFromOperationsIsOrderIndependent(t *testing.T) {
+	debitFirst := []*types.Operation{operation("0xsender", "-100"), operation("0xrecipient", "100")}
+	creditFirst := []*types.Operation{operation("0xrecipient", "100"), operation("0xsender", "-100")}
+
+	for _, ops := range [][]*types.Operation{debitFirst, creditFirst} {
+		transfer, err := transferFromOperations(ops)
+		if err != nil {
+			t.Fatalf("transferFromOperations: %v", err)
+		}
+		if transfer.From != "0xsender" || transfer.To != "0xrecipient" || transfer.Amount != "100" {
+			t.Errorf("transferFromOperations(%v) = %+v, want From=0xsender To=0xrecipient Amount=100", ops, transfer)
+		}
+	}
+}
+
+func TestTransferFromOperationsRejectsMalformedInput(t *testing.T) {
+	cases := [][]*types.Operation{
+		{operation("0xa", "-100")},                                                      // wrong count
+		{operation("0xa", "-100"), operation("0xb", "-100")},                            // two debits
+		{operation("0xa", "100"), operation("0xb", "100")},                              // two credits
+		{{Account: nil, Amount: &types.Amount{Value: "-100"}}, operation("0xb", "100")}, // missing account
+	}
+
+	for i, ops := range cases {
+		if _, err := transferFromOperations(ops); err == nil {
+			t.Errorf("case %d: expected an error, got nil", i)
+		}
+	}
+}
+
+// TestConstructionRoundTrip exercises preprocess -> metadata -> payloads ->
+// combine -> submit end to end against the fake node.
+func TestConstructionRoundTrip(t *testing.T) {
+	client := newTestClient(t)
+	r := NewClient(client)
+
+	ops := []*types.Operation{operation("0xsender", "-100"), operation("0xrecipient", "100")}
+
+	preprocess, err := r.ConstructionPreprocess(ops)
+	if err != nil {
+		t.Fatalf("ConstructionPreprocess: %v", err)
+	}
+
+	metadataResp, err := r.ConstructionMetadata(context.Background(), preprocess.Options)
+	if err != nil {
+		t.Fatalf("ConstructionMetadata: %v", err)
+	}
+	if nonce, _ := metadataResp.Metadata["nonce"].(float64); int64(nonce) != 7 {
+		t.Errorf("metadata nonce = %v, want 7 (from the fake sender account)", metadataResp.Metadata["nonce"])
+	}
+
+	payloadsResp, err := r.ConstructionPayloads(metadataResp.Metadata)
+	if err != nil {
+		t.Fatalf("ConstructionPayloads: %v", err)
+	}
+	if !strings.Contains(payloadsResp.UnsignedTransaction, `"nonce":7`) {
+		t.Errorf("unsigned transaction %q does not carry the fetched nonce", payloadsResp.UnsignedTransaction)
+	}
+
+	combineResp, err := r.ConstructionCombine(payloadsResp.UnsignedTransaction, []*types.Signature{
+		{Bytes: []byte{0xde, 0xad, 0xbe, 0xef}},
+	})
+	if err != nil {
+		t.Fatalf("ConstructionCombine: %v", err)
+	}
+
+	submitResp, err := r.ConstructionSubmit(context.Background(), combineResp.SignedTransaction)
+	if err != nil {
+		t.Fatalf("ConstructionSubmit: %v", err)
+	}
+	if submitResp.TransactionIdentifier.Hash != "0xsubmitted" {
+		t.Errorf("TransactionIdentifier.Hash = %q, want 0xsubmitted", submitResp.TransactionIdentifier.Hash)
+	}
+}
+
+func TestBlockResolvesRequestedIdentifierNotLatest(t *testing.T) {
+	client := newTestClient(t)
+	r := NewClient(client)
+
+	byIndex := int64(42)
+	resp, err := r.Block(context.Background(), &types.PartialBlockIdentifier{Index: &byIndex})
+	if err != nil {
+		t.Fatalf("Block: %v", err)
+	}
+	if resp.Block.BlockIdentifier.Index != 42 || resp.Block.BlockIdentifier.Hash != "0xblock42" {
+		t.Errorf("Block(index=42) returned %+v, want the requested block, not the tip", resp.Block.BlockIdentifier)
+	}
+
+	hash := "0xblock42"
+	resp, err = r.Block(context.Background(), &types.PartialBlockIdentifier{Hash: &hash})
+	if err != nil {
+		t.Fatalf("Block: %v", err)
+	}
+	if resp.Block.BlockIdentifier.Index != 42 {
+		t.Errorf("Block(hash=0xblock42) returned index %d, want 42", resp.Block.BlockIdentifier.Index)
+	}
+
+	resp, err = r.Block(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Block(nil): %v", err)
+	}
+	if resp.Block.BlockIdentifier.Index != 100 {
+		t.Errorf("Block(nil) returned index %d, want 100 (the latest block)", resp.Block.BlockIdentifier.Index)
+	}
+}
+
+func TestBlockTransaction(t *testing.T) {
+	client := newTestClient(t)
+	r := NewClient(client)
+
+	resp, err := r.BlockTransaction(context.Background(), "0xtxhash")
+	if err != nil {
+		t.Fatalf("BlockTransaction: %v", err)
+	}
+	if resp.Transaction.TransactionIdentifier.Hash != "0xtxhash" {
+		t.Errorf("Hash = %q, want 0xtxhash", resp.Transaction.TransactionIdentifier.Hash)
+	}
+	if len(resp.Transaction.Operations) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(resp.Transaction.Operations))
+	}
+}
+
+func TestAccountBalance(t *testing.T) {
+	client := newTestClient(t)
+	r := NewClient(client)
+
+	resp, err := r.AccountBalance(context.Background(), "0xsender")
+	if err != nil {
+		t.Fatalf("AccountBalance: %v", err)
+	}
+	if len(resp.Balances) != 1 || resp.Balances[0].Value != "500" {
+		t.Errorf("Balances = %+v, want a single balance of 500", resp.Balances)
+	}
+	if resp.BlockIdentifier.Index != 100 {
+		t.Errorf("BlockIdentifier.Index = %d, want 100 (the latest block)", resp.BlockIdentifier.Index)
+	}
+}
+
+func TestNetworkStatus(t *testing.T) {
+	client := newTestClient(t)
+	r := NewClient(client)
+
+	resp, err := r.NetworkStatus(context.Background())
+	if err != nil {
+		t.Fatalf("NetworkStatus: %v", err)
+	}
+	if resp.CurrentBlockIdentifier.Index != 100 || resp.CurrentBlockIdentifier.Hash != "0xtip" {
+		t.Errorf("CurrentBlockIdentifier = %+v, want index 100 hash 0xtip", resp.CurrentBlockIdentifier)
+	}
+}
+
+func TestNetworkIdentifier(t *testing.T) {
+	r := NewClient(nil)
+	id := r.NetworkIdentifier()
+	if id.Blockchain != BlockchainName || id.Network != NetworkName {
+		t.Errorf("NetworkIdentifier() = %+v, want %s/%s", id, BlockchainName, NetworkName)
+	}
+}
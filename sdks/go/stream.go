@@ -0,0 +1,270 @@
+package aurigraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsInitialBackoff = 500 * time.Millisecond
+	wsMaxBackoff     = 30 * time.Second
+)
+
+// TxFilter narrows a transaction subscription to transactions touching the
+// given addresses. Empty fields match any address.
+type TxFilter struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// subscribeRequest is the JSON-RPC-style message sent to open a subscription.
+type subscribeRequest struct {
+	Method string      `json:"method"`
+	Params interface{} `json:"params,omitempty"`
+}
+
+// notification is the JSON-RPC-style message the server pushes for an open
+// subscription.
+type notification struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// Subscription represents a live streaming subscription opened by one of the
+// Client.Subscribe* methods.
+type Subscription struct {
+	client *Client
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// wsConnHolder guards the subscription's current *websocket.Conn so the read
+// loop (which replaces it on reconnect) and Unsubscribe's closer goroutine
+// never race over which connection is live.
+type wsConnHolder struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (h *wsConnHolder) set(conn *websocket.Conn) {
+	h.mu.Lock()
+	h.conn = conn
+	h.mu.Unlock()
+}
+
+// close closes whatever connection is currently held, if any.
+func (h *wsConnHolder) close() {
+	h.mu.Lock()
+	conn := h.conn
+	h.mu.Unlock()
+	if conn != nil {
+		conn.Close()
+	}
+}
+
+// Unsubscribe tears down the subscription's connection and closes its channel.
+func (s *Subscription) Unsubscribe() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// SubscribeBlocks streams every new block as it is produced.
+func (c *Client) SubscribeBlocks(ctx context.Context) (<-chan *Block, *Subscription, error) {
+	out := make(chan *Block, 16)
+	sub, err := c.openSubscription(ctx, "subscribe_blocks", nil, func(raw json.RawMessage) {
+		var block Block
+		if err := json.Unmarshal(raw, &block); err == nil {
+			out <- &block
+		}
+	}, func() { close(out) })
+	return out, sub, err
+}
+
+// SubscribeTransactions streams transactions matching filter as they land.
+func (c *Client) SubscribeTransactions(ctx context.Context, filter TxFilter) (<-chan *Transaction, *Subscription, error) {
+	out := make(chan *Transaction, 16)
+	sub, err := c.openSubscription(ctx, "subscribe_transactions", filter, func(raw json.RawMessage) {
+		var tx Transaction
+		if err := json.Unmarshal(raw, &tx); err == nil {
+			out <- &tx
+		}
+	}, func() { close(out) })
+	return out, sub, err
+}
+
+// SubscribeAccount streams the account's state every time it changes.
+func (c *Client) SubscribeAccount(ctx context.Context, address string) (<-chan *Account, *Subscription, error) {
+	out := make(chan *Account, 16)
+	sub, err := c.openSubscription(ctx, "subscribe_account", struct {
+		Address string `json:"address"`
+	}{Address: address}, func(raw json.RawMessage) {
+		var account Account
+		if err := json.Unmarshal(raw, &account); err == nil {
+			out <- &account
+		}
+	}, func() { close(out) })
+	return out, sub, err
+}
+
+// openSubscription dials the streaming endpoint, sends the subscribe
+// request, and runs a read loop that hands each notification's params to
+// onMessage. It reconnects with exponential backoff on disconnect, resuming
+// from the last block height it observed, until the subscription's context
+// is cancelled.
+func (c *Client) openSubscription(ctx context.Context, method string, params interface{}, onMessage func(json.RawMessage), onClose func()) (*Subscription, error) {
+	wsURL, err := c.websocketURL()
+	if err != nil {
+		return nil, err
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := &Subscription{client: c, cancel: cancel, done: make(chan struct{})}
+
+	conn, err := c.dialWebsocket(subCtx, wsURL)
+	if err != nil {
+		cancel()
+		close(sub.done)
+		return nil, fmt.Errorf("aurigraph: failed to open subscription: %w", err)
+	}
+
+	holder := &wsConnHolder{}
+	holder.set(conn)
+
+	var lastHeight int64
+	var mu sync.Mutex
+
+	send := func(conn *websocket.Conn) error {
+		req := subscribeRequest{Method: method, Params: params}
+		mu.Lock()
+		if lastHeight > 0 {
+			req.Params = resumeParams{Base: params, FromHeight: lastHeight}
+		}
+		mu.Unlock()
+		return conn.WriteJSON(req)
+	}
+
+	if err := send(conn); err != nil {
+		holder.close()
+		cancel()
+		close(sub.done)
+		return nil, fmt.Errorf("aurigraph: failed to send subscribe request: %w", err)
+	}
+
+	go func() {
+		defer close(sub.done)
+		defer onClose()
+
+		backoff := wsInitialBackoff
+		for {
+			// conn is nil after a failed redial; go straight to the next backoff.
+			for conn != nil {
+				var note notification
+				if err := conn.ReadJSON(&note); err != nil {
+					holder.close()
+					break
+				}
+
+				var block Block
+				if json.Unmarshal(note.Params, &block) == nil && block.Height > 0 {
+					mu.Lock()
+					lastHeight = block.Height
+					mu.Unlock()
+				}
+				onMessage(note.Params)
+			}
+
+			select {
+			case <-subCtx.Done():
+				return
+			default:
+			}
+
+			time.Sleep(jitter(backoff))
+			backoff *= 2
+			if backoff > wsMaxBackoff {
+				backoff = wsMaxBackoff
+			}
+
+			var dialErr error
+			conn, dialErr = c.dialWebsocket(subCtx, wsURL)
+			if dialErr != nil {
+				select {
+				case <-subCtx.Done():
+					return
+				default:
+					continue
+				}
+			}
+			holder.set(conn)
+			if err := send(conn); err != nil {
+				holder.close()
+				continue
+			}
+			backoff = wsInitialBackoff
+		}
+	}()
+
+	go func() {
+		<-subCtx.Done()
+		holder.close()
+	}()
+
+	return sub, nil
+}
+
+// resumeParams wraps the original subscription params with the last block
+// height seen before a reconnect, so the server can replay anything missed.
+type resumeParams struct {
+	Base       interface{} `json:"params,omitempty"`
+	FromHeight int64       `json:"fromHeight"`
+}
+
+func jitter(d time.Duration) time.Duration {
+	return d/2 + time.Duration(rand.Int63n(int64(d)/2+1))
+}
+
+// websocketURL derives the streaming endpoint from the first configured
+// node, translating http(s) to ws(s).
+func (c *Client) websocketURL() (string, error) {
+	c.poolMu.RLock()
+	defer c.poolMu.RUnlock()
+
+	if len(c.pool) == 0 {
+		return "", fmt.Errorf("aurigraph: no endpoints configured")
+	}
+
+	u, err := url.Parse(c.pool[0].URL)
+	if err != nil {
+		return "", fmt.Errorf("aurigraph: invalid endpoint URL: %w", err)
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/ws"
+
+	return u.String(), nil
+}
+
+func (c *Client) dialWebsocket(ctx context.Context, wsURL string) (*websocket.Conn, error) {
+	header := http.Header{}
+	if c.config.APIKey != "" {
+		header.Set("X-API-Key", c.config.APIKey)
+	}
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, wsURL, header)
+	return conn, err
+}
@@ -0,0 +1,113 @@
+package aurigraph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestWebsocketURLTranslatesScheme(t *testing.T) {
+	cases := []struct {
+		name     string
+		endpoint string
+		want     string
+	}{
+		{"http to ws", "http://node.example.com", "ws://node.example.com/ws"},
+		{"https to wss", "https://node.example.com", "wss://node.example.com/ws"},
+		{"trailing slash trimmed", "https://node.example.com/", "wss://node.example.com/ws"},
+		{"existing path preserved", "https://node.example.com/api/v11", "wss://node.example.com/api/v11/ws"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{pool: []*Node{{URL: tc.endpoint}}}
+
+			got, err := c.websocketURL()
+			if err != nil {
+				t.Fatalf("websocketURL: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("websocketURL() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWebsocketURLErrorsWithNoEndpoints(t *testing.T) {
+	c := &Client{}
+	if _, err := c.websocketURL(); err == nil {
+		t.Fatal("expected an error when no endpoints are configured")
+	}
+}
+
+func TestJitterStaysWithinBounds(t *testing.T) {
+	d := 4 * time.Second
+	for i := 0; i < 100; i++ {
+		got := jitter(d)
+		if got < d/2 || got > d {
+			t.Fatalf("jitter(%s) = %s, want a value in [%s, %s]", d, got, d/2, d)
+		}
+	}
+}
+
+// TestSubscribeBlocksReconnects opens a blocks subscription against a server
+// that drops the first connection after one message, and checks the client
+// reconnects and keeps delivering blocks rather than giving up.
+func TestSubscribeBlocksReconnects(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	var connCount int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		connCount++
+		n := connCount
+
+		var req subscribeRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+
+		if n == 1 {
+			conn.WriteJSON(notification{Method: "block", Params: []byte(`{"hash":"0xblock1","height":1}`)})
+			return // drop the connection, forcing a reconnect
+		}
+
+		conn.WriteJSON(notification{Method: "block", Params: []byte(`{"hash":"0xblock2","height":2}`)})
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	c := &Client{
+		config: &ClientConfig{},
+		pool:   []*Node{{URL: server.URL}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blocks, sub, err := c.SubscribeBlocks(ctx)
+	if err != nil {
+		t.Fatalf("SubscribeBlocks: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	for _, wantHash := range []string{"0xblock1", "0xblock2"} {
+		select {
+		case block := <-blocks:
+			if block.Hash != wantHash {
+				t.Errorf("block.Hash = %q, want %q", block.Hash, wantHash)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for block %q after a reconnect", wantHash)
+		}
+	}
+}
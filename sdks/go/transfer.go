@@ -0,0 +1,38 @@
+package aurigraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BuildTransfer fetches from's current nonce and assembles an unsigned
+// transfer transaction, ready to be handed to a wallet.Account for signing.
+func (c *Client) BuildTransfer(ctx context.Context, from, to, amount string) (*UnsignedTransaction, error) {
+	account, err := c.GetAccount(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transfer: %w", err)
+	}
+
+	return &UnsignedTransaction{
+		From:   from,
+		To:     to,
+		Amount: amount,
+		Nonce:  account.Nonce,
+	}, nil
+}
+
+// SendSigned submits a transaction that has already been signed client-side.
+func (c *Client) SendSigned(ctx context.Context, signed *SignedTransaction) (*Transaction, error) {
+	raw, err := json.Marshal(signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal signed transaction: %w", err)
+	}
+
+	var tx map[string]interface{}
+	if err := json.Unmarshal(raw, &tx); err != nil {
+		return nil, fmt.Errorf("failed to marshal signed transaction: %w", err)
+	}
+
+	return c.SubmitTransaction(ctx, tx)
+}
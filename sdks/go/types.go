@@ -1,10 +1,26 @@
 package aurigraph
 
+import "encoding/json"
+
 // ClientConfig represents configuration for the Aurigraph client
 type ClientConfig struct {
-	BaseURL string
-	APIKey  string
-	Timeout int // timeout in milliseconds
+	// Endpoints is the pool of Aurigraph node URLs the client load-balances
+	// across (e.g. "https://dlt.aurigraph.io/api/v11"). At least one is required.
+	Endpoints []string
+	APIKey    string
+	Timeout   int // timeout in milliseconds
+
+	// MaxRetries is how many additional nodes a request will be retried
+	// against after the first attempt fails with a network error or 5xx.
+	MaxRetries int
+
+	// HealthCheckInterval controls how often (in milliseconds) nodes are
+	// polled via /health and /blocks/latest.
+	HealthCheckInterval int
+
+	// MaxBlockLag is how far behind the highest observed block height an
+	// otherwise-healthy node may fall before it is marked down.
+	MaxBlockLag int64
 }
 
 // Account represents account information on the blockchain
@@ -38,3 +54,36 @@ type Block struct {
 type HealthResponse struct {
 	Status string `json:"status"`
 }
+
+// NodeStatus is a point-in-time snapshot of a pooled node's health, returned
+// by Client.PoolStatus.
+type NodeStatus struct {
+	URL            string `json:"url"`
+	Alive          bool   `json:"alive"`
+	LastSeenHeight int64  `json:"lastSeenHeight"`
+	InFlight       int64  `json:"inFlight"`
+}
+
+// UnsignedTransaction is a transfer intent built client-side, ahead of
+// signing, by Client.BuildTransfer. Its field order is part of the
+// canonical serialization signers hash, so it must not change.
+type UnsignedTransaction struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	Nonce  int64  `json:"nonce"`
+}
+
+// CanonicalBytes returns the deterministic JSON encoding of the transaction,
+// i.e. the exact bytes a signer must hash and sign.
+func (tx *UnsignedTransaction) CanonicalBytes() ([]byte, error) {
+	return json.Marshal(tx)
+}
+
+// SignedTransaction is an UnsignedTransaction plus the signer's public key
+// and signature over its CanonicalBytes, ready for Client.SendSigned.
+type SignedTransaction struct {
+	UnsignedTransaction
+	PublicKey string `json:"publicKey"`
+	Signature string `json:"signature"`
+}
@@ -0,0 +1,177 @@
+// Package wallet provides client-side keypair management and transaction
+// signing for the Aurigraph SDK, so a transaction's signature never has to
+// be produced by, or trusted from, the server.
+package wallet
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+)
+
+// KeyType identifies which signature scheme an Account uses.
+type KeyType int
+
+const (
+	// Secp256k1 is the default scheme, used by NewAccount,
+	// AccountFromPrivateKey and AccountFromMnemonic.
+	Secp256k1 KeyType = iota
+	Ed25519
+)
+
+// DefaultDerivationPath is the BIP-44-style path used by AccountFromMnemonic
+// when the caller doesn't need a non-default account/index.
+const DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+// Account is a local keypair capable of signing Aurigraph transactions.
+type Account struct {
+	keyType KeyType
+	secp    *btcec.PrivateKey
+	ed      ed25519.PrivateKey
+	address string
+}
+
+// NewAccount generates a fresh secp256k1 keypair.
+func NewAccount() (*Account, error) {
+	priv, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("wallet: failed to generate key: %w", err)
+	}
+	return newSecp256k1Account(priv), nil
+}
+
+// AccountFromPrivateKey loads a secp256k1 account from a hex-encoded
+// 32-byte private key.
+func AccountFromPrivateKey(hexKey string) (*Account, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("wallet: invalid private key: %w", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("wallet: private key must be 32 bytes, got %d", len(raw))
+	}
+
+	priv, _ := btcec.PrivKeyFromBytes(raw)
+	return newSecp256k1Account(priv), nil
+}
+
+// AccountFromMnemonic derives a secp256k1 account from a BIP-39 mnemonic
+// and a BIP-32 derivation path (e.g. DefaultDerivationPath).
+func AccountFromMnemonic(mnemonic, path string) (*Account, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("wallet: invalid mnemonic")
+	}
+
+	seed := bip39.NewSeed(mnemonic, "")
+
+	master, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: failed to derive master key: %w", err)
+	}
+
+	key := master
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "m/"), "/") {
+		hardened := strings.HasSuffix(segment, "'")
+		index, err := strconv.ParseUint(strings.TrimSuffix(segment, "'"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("wallet: invalid derivation path %q: %w", path, err)
+		}
+		if hardened {
+			index += uint64(bip32.FirstHardenedChild)
+		}
+		key, err = key.NewChildKey(uint32(index))
+		if err != nil {
+			return nil, fmt.Errorf("wallet: failed to derive path %q: %w", path, err)
+		}
+	}
+
+	priv, _ := btcec.PrivKeyFromBytes(key.Key)
+	return newSecp256k1Account(priv), nil
+}
+
+// NewEd25519Account generates a fresh ed25519 keypair.
+func NewEd25519Account() (*Account, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: failed to generate ed25519 key: %w", err)
+	}
+	return &Account{
+		keyType: Ed25519,
+		ed:      priv,
+		address: addressFromPublicKey(priv.Public().(ed25519.PublicKey)),
+	}, nil
+}
+
+func newSecp256k1Account(priv *btcec.PrivateKey) *Account {
+	return &Account{
+		keyType: Secp256k1,
+		secp:    priv,
+		address: addressFromPublicKey(priv.PubKey().SerializeCompressed()),
+	}
+}
+
+// addressFromPublicKey derives an Aurigraph address as "0x" followed by the
+// hex encoding of the first 20 bytes of the SHA-256 digest of the public key
+// bytes.
+//
+// TODO(wallet): this derivation is provisional. It is not yet confirmed
+// against the address scheme the live Aurigraph network actually computes
+// from an account's public key — do not rely on addresses produced here
+// matching an on-chain account until that's verified against the network's
+// documented spec. TestAddressFromPublicKey pins the current output as a
+// regression guard in the meantime.
+func addressFromPublicKey(pub []byte) string {
+	digest := sha256.Sum256(pub)
+	return "0x" + hex.EncodeToString(digest[:20])
+}
+
+// Address returns the account's Aurigraph address.
+func (a *Account) Address() string {
+	return a.address
+}
+
+// PublicKeyHex returns the account's public key, hex-encoded.
+func (a *Account) PublicKeyHex() string {
+	switch a.keyType {
+	case Ed25519:
+		return hex.EncodeToString(a.ed.Public().(ed25519.PublicKey))
+	default:
+		return hex.EncodeToString(a.secp.PubKey().SerializeCompressed())
+	}
+}
+
+// Sign signs tx's canonical serialization and returns the assembled signed
+// transaction, ready for Client.SendSigned.
+func (a *Account) Sign(tx *aurigraph.UnsignedTransaction) (*aurigraph.SignedTransaction, error) {
+	payload, err := tx.CanonicalBytes()
+	if err != nil {
+		return nil, fmt.Errorf("wallet: failed to serialize transaction: %w", err)
+	}
+
+	var signature []byte
+	switch a.keyType {
+	case Ed25519:
+		signature = ed25519.Sign(a.ed, payload)
+	default:
+		digest := sha256.Sum256(payload)
+		sig := ecdsa.Sign(a.secp, digest[:])
+		signature = sig.Serialize()
+	}
+
+	return &aurigraph.SignedTransaction{
+		UnsignedTransaction: *tx,
+		PublicKey:           a.PublicKeyHex(),
+		Signature:           hex.EncodeToString(signature),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package wallet
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	"github.com/Aurigraph-DLT-Corp/Aurigraph-Developer-Toolkit-and-SDK/sdks/go"
+)
+
+// TestAddressFromPublicKey pins the current (provisional) address
+// derivation against a fixed private key, so any unintentional change to it
+// is caught here rather than discovered against the live network.
+func TestAddressFromPublicKey(t *testing.T) {
+	acct, err := AccountFromPrivateKey("1111111111111111111111111111111111111111111111111111111111111111")
+	if err != nil {
+		t.Fatalf("AccountFromPrivateKey: %v", err)
+	}
+
+	const wantAddress = "0x5b6b92b37b765963ab61d52a3171a54da33778c1"
+	if got := acct.Address(); got != wantAddress {
+		t.Errorf("Address() = %q, want %q", got, wantAddress)
+	}
+
+	const wantPubKey = "034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa"
+	if got := acct.PublicKeyHex(); got != wantPubKey {
+		t.Errorf("PublicKeyHex() = %q, want %q", got, wantPubKey)
+	}
+}
+
+func TestAccountFromPrivateKeyRejectsBadInput(t *testing.T) {
+	if _, err := AccountFromPrivateKey("not-hex"); err == nil {
+		t.Fatal("expected error for non-hex private key")
+	}
+	if _, err := AccountFromPrivateKey("abcd"); err == nil {
+		t.Fatal("expected error for short private key")
+	}
+}
+
+// TestSignProducesVerifiableSignature checks that Sign's output verifies
+// against the account's own public key over the transaction's canonical
+// serialization, independent of how addressFromPublicKey happens to work.
+func TestSignProducesVerifiableSignature(t *testing.T) {
+	acct, err := NewAccount()
+	if err != nil {
+		t.Fatalf("NewAccount: %v", err)
+	}
+
+	tx := &aurigraph.UnsignedTransaction{
+		From:   acct.Address(),
+		To:     "0xrecipient",
+		Amount: "100",
+		Nonce:  1,
+	}
+
+	signed, err := acct.Sign(tx)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	canonical, err := tx.CanonicalBytes()
+	if err != nil {
+		t.Fatalf("CanonicalBytes: %v", err)
+	}
+
+	pubKeyBytes, err := hex.DecodeString(signed.PublicKey)
+	if err != nil {
+		t.Fatalf("decode public key: %v", err)
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		t.Fatalf("ParsePubKey: %v", err)
+	}
+
+	sigBytes, err := hex.DecodeString(signed.Signature)
+	if err != nil {
+		t.Fatalf("decode signature: %v", err)
+	}
+	sig, err := ecdsa.ParseDERSignature(sigBytes)
+	if err != nil {
+		t.Fatalf("ParseDERSignature: %v", err)
+	}
+
+	digest := sha256.Sum256(canonical)
+	if !sig.Verify(digest[:], pubKey) {
+		t.Error("signature does not verify against the account's own public key")
+	}
+}
+
+// TestCanonicalBytesIsDeterministic locks UnsignedTransaction's wire format,
+// since a signer hashes exactly these bytes.
+func TestCanonicalBytesIsDeterministic(t *testing.T) {
+	tx := &aurigraph.UnsignedTransaction{From: "0xfrom", To: "0xto", Amount: "42", Nonce: 7}
+
+	const want = `{"from":"0xfrom","to":"0xto","amount":"42","nonce":7}`
+	got, err := tx.CanonicalBytes()
+	if err != nil {
+		t.Fatalf("CanonicalBytes: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("CanonicalBytes() = %s, want %s", got, want)
+	}
+}